@@ -0,0 +1,203 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// InputConfig defines options on an asset directory to be convert.
+type InputConfig struct {
+	// Path defines a directory containing asset files to be included
+	// in the generated output.
+	Path string
+
+	// Recursive defines whether subdirectories of Path
+	// should be included in the generated output.
+	Recursive bool
+}
+
+// Config defines a set of options for the asset conversion process.
+type Config struct {
+	// Package defines the name of the package to use. Defaults to 'main'.
+	Package string
+
+	// Tags specifies a set of optional build tags, which will be
+	// included in the generated output. Multiple tags can be
+	// specified by separating them with a space.
+	Tags string
+
+	// Prefix defines a path prefix which should be stripped from all
+	// file names when generating the keys in the table of contents.
+	Prefix string
+
+	// Input defines the directory paths, containing all asset files.
+	Input []InputConfig
+
+	// Output defines the output file for the generated code.
+	Output string
+
+	// Ignore defines a set of regexes for path matching, causing
+	// matching files to be ignored during the conversion.
+	Ignore []*regexp.Regexp
+
+	// IgnoreGlobs defines a set of gitignore-style patterns (e.g.
+	// "**/*.map", "node_modules/", "!keep.map") evaluated in order,
+	// later patterns overriding earlier ones exactly like a
+	// .gitignore file. It composes with Ignore: a path is excluded if
+	// either ignore mechanism excludes it, with IgnoreGlobs negations
+	// (leading "!") applied last.
+	IgnoreGlobs []string
+
+	// globs holds IgnoreGlobs once parsed by validate.
+	globs []globPattern
+
+	// NoCompress means disable compression of the assets. It is a
+	// shorthand for Compression = CompressionNone.
+	NoCompress bool
+
+	// Compression selects the codec used to compress compiled-in asset
+	// bytes. Defaults to CompressionGzip. Regardless of this setting,
+	// an individual asset whose content already looks compressed (see
+	// isPrecompressed) is stored uncompressed, since recompressing it
+	// would only add overhead.
+	Compression CompressionType
+
+	// Debug means the assets are read directly from disk at runtime,
+	// using the path they were found at during conversion, rather than
+	// being compiled into the binary.
+	Debug bool
+
+	// Dev is identical to Debug, except that it also hot-reloads asset
+	// paths that are added after the initial conversion.
+	Dev bool
+
+	// FS supplies the filesystem Translate reads assets from. It
+	// defaults to the local disk (via os) when left nil, but callers
+	// may substitute an in-memory tree, a zip/tarball, or an
+	// afero-backed overlay to drive Translate without touching disk.
+	FS SourceFS
+
+	// EmitFS causes the generated output to additionally expose its
+	// compiled-in assets as an io/fs.FS (exported as AssetsFS), so the
+	// generated package can be handed directly to APIs like
+	// http.FileServer, template.ParseFS or text/template.
+	EmitFS bool
+
+	// EmitHTTPFileSystem causes the generated output to expose an
+	// additional AssetsHTTPFS variable, which wraps AssetsFS with
+	// http.FS for use with http.FileServer on older net/http call
+	// sites that still expect an http.FileSystem. Implies EmitFS.
+	EmitHTTPFileSystem bool
+
+	// Parallelism controls how many assets are compressed and encoded
+	// concurrently during the release build. 0 (the default) uses
+	// runtime.GOMAXPROCS(0). The generated output is byte-identical
+	// regardless of this setting; it only affects how fast Translate
+	// produces it.
+	Parallelism int
+
+	// MaxFileSize caps how large the generated release file is allowed
+	// to grow before Translate shards the asset table across
+	// "<base>_0.go", "<base>_1.go", etc. alongside a shared "<base>.go"
+	// holding the TOC, tree and public API. 0 (the default) uses
+	// DefaultMaxFileSize; a negative value disables sharding entirely.
+	MaxFileSize int64
+
+	// Integrity enables computing Subresource Integrity digests for
+	// each asset, from its uncompressed bytes, and exposes them via
+	// AssetIntegrity and AssetsIntegrity. It has no effect with Debug or
+	// Dev set: their output does not include these accessors.
+	Integrity bool
+
+	// IntegrityAlgorithms selects which hash algorithms to compute
+	// when Integrity is enabled. Defaults to just IntegritySHA256.
+	IntegrityAlgorithms []IntegrityAlgorithm
+}
+
+// DefaultMaxFileSize is the MaxFileSize Translate uses when Config
+// leaves it unset.
+const DefaultMaxFileSize int64 = 4 * 1024 * 1024
+
+// validate ensures the config has sane values. Part of which means
+// checking if certain file/directory paths exist.
+func (c *Config) validate() error {
+	if len(c.Package) == 0 {
+		return fmt.Errorf("missing package name")
+	}
+
+	if c.FS == nil {
+		c.FS = osFS{}
+	}
+
+	if c.EmitHTTPFileSystem {
+		c.EmitFS = true
+	}
+
+	c.globs = compileIgnoreGlobs(c.IgnoreGlobs)
+
+	if c.NoCompress {
+		c.Compression = CompressionNone
+	}
+
+	if c.MaxFileSize == 0 {
+		c.MaxFileSize = DefaultMaxFileSize
+	}
+
+	if c.Integrity && len(c.IntegrityAlgorithms) == 0 {
+		c.IntegrityAlgorithms = []IntegrityAlgorithm{IntegritySHA256}
+	}
+
+	for _, input := range c.Input {
+		_, err := c.FS.Stat(input.Path)
+		if err != nil {
+			return fmt.Errorf("failed to stat input path '%s': %v", input.Path, err)
+		}
+	}
+
+	if len(c.Output) == 0 {
+		return fmt.Errorf("missing output file")
+	}
+
+	stat, err := os.Stat(filepath.Dir(c.Output))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		dir, _ := filepath.Split(c.Output)
+		if dir != "" {
+			dir = filepath.Clean(dir)
+			if err := os.MkdirAll(dir, 0744); err != nil {
+				return err
+			}
+		}
+	} else if !stat.IsDir() {
+		return fmt.Errorf("output path is not a directory")
+	}
+
+	return nil
+}
+
+// Asset holds information about a single asset to be processed.
+type Asset struct {
+	Path string // Full file path.
+	Name string // Key used in TOC -- name by which asset is referenced.
+	Func string // Function name for the procedure returning the asset contents.
+
+	// Hash is a content hash of the asset's bytes, computed by
+	// findFiles. Assets sharing a Hash have identical content, letting
+	// Translate compile the bytes in just once (see DupOf).
+	Hash string
+
+	// DupOf is the Func of the first asset found with this Hash, set by
+	// Translate once every input has been walked. It's empty for the
+	// first asset with a given Hash -- the one whose bytes are actually
+	// compiled in -- and set on every later asset with the same
+	// content, which instead gets a thin wrapper delegating to DupOf.
+	DupOf string
+}
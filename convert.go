@@ -6,8 +6,11 @@ package bindata
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"go/format"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -32,10 +35,30 @@ func Translate(c *Config) error {
 	var visitedPaths = make(map[string]bool)
 	// Locate all the assets.
 	for _, input := range c.Input {
-		err = findFiles(input.Path, c.Prefix, input.Recursive, &toc, c.Ignore, knownFuncs, visitedPaths)
+		root, err := filepath.Abs(input.Path)
 		if err != nil {
 			return err
 		}
+		err = findFiles(c.FS, input.Path, root, c.Prefix, input.Recursive, &toc, c.Ignore, c.globs, knownFuncs, visitedPaths, !c.Debug && !c.Dev)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Group assets by content hash, so writeRelease can compile identical
+	// payloads in just once: the first asset with a given Hash keeps
+	// DupOf empty, and every later one with the same Hash is marked as a
+	// duplicate of it.
+	seenHashes := make(map[string]string, len(toc))
+	for i := range toc {
+		if toc[i].Hash == "" {
+			continue
+		}
+		if canonicalFunc, ok := seenHashes[toc[i].Hash]; ok {
+			toc[i].DupOf = canonicalFunc
+		} else {
+			seenHashes[toc[i].Hash] = toc[i].Func
+		}
 	}
 
 	// Create output file.
@@ -76,28 +99,70 @@ func Translate(c *Config) error {
 		return err
 	}
 
-	// Write assets.
-	if c.Debug || c.Dev {
-		if os.Getenv("GO_BINDATA_TEST") == "true" {
-			// If we don't do this, people running the tests on different
-			// machines get different git diffs.
-			for i := range toc {
-				toc[i].Path = strings.Replace(toc[i].Path, wd, "/test", 1)
-			}
-		}
-		err = writeDebug(buf, c, toc)
-	} else {
-		err = writeRelease(buf, c, toc)
+	// Write the import block for whichever features are enabled.
+	if err = writeImports(buf, c); err != nil {
+		return err
 	}
 
-	if err != nil {
+	// Write the asset wrapper type shared by both the debug and release
+	// code paths.
+	if _, err = fmt.Fprint(buf, "type asset struct {\n\tbytes []byte\n\tinfo  os.FileInfo\n}\n\n"); err != nil {
 		return err
 	}
 
-	// Write table of contents
-	if err := writeTOC(buf, toc); err != nil {
-		return err
+	// Write assets, and the table of contents' API and (initially
+	// empty) _bindata table they read from.
+	var shards map[string][]byte
+	if c.Debug || c.Dev {
+		if err = writeDebug(buf, c, toc); err != nil {
+			return err
+		}
+		if err = writeTOC(buf); err != nil {
+			return err
+		}
+		if err = writeTOCRegister(buf, toc); err != nil {
+			return err
+		}
+	} else {
+		if err = writeReleaseHeader(buf, c); err != nil {
+			return err
+		}
+		if err = writeTOC(buf); err != nil {
+			return err
+		}
+		if c.Integrity {
+			if err = writeIntegrityHeader(buf); err != nil {
+				return err
+			}
+		}
+
+		chunks, err := encodeReleaseAssets(c, toc)
+		if err != nil {
+			return err
+		}
+		digests := buildIntegrityDigests(toc, chunks)
+
+		shards, err = shardReleaseAssets(c, toc, chunks, digests)
+		if err != nil {
+			return err
+		}
+		if shards == nil {
+			for _, chunk := range chunks {
+				if _, err := buf.Write(chunk.src); err != nil {
+					return err
+				}
+			}
+			if err := writeTOCRegister(buf, toc); err != nil {
+				return err
+			}
+			if c.Integrity {
+				if err := writeIntegrityRegister(buf, toc, digests); err != nil {
+					return err
+				}
+			}
+		}
 	}
+
 	// Write hierarchical tree of assets
 	if err := writeTOCTree(buf, toc); err != nil {
 		return err
@@ -107,12 +172,37 @@ func Translate(c *Config) error {
 	if err := writeRestore(buf); err != nil {
 		return err
 	}
+
+	// Write an io/fs.FS implementation over the assets, if requested.
+	if c.EmitFS {
+		if err := writeFS(buf, c); err != nil {
+			return err
+		}
+	}
 	fmted, err := format.Source(buf.Bytes())
 	if err != nil {
 		return err
 	}
 
-	return safefileWriteFile(c.Output, fmted, 0666)
+	if shards == nil {
+		if err := safefileWriteFile(c.Output, fmted, 0666); err != nil {
+			return err
+		}
+		return removeStaleShards(c, nil)
+	}
+
+	files := map[string][]byte{c.Output: fmted}
+	for name, content := range shards {
+		formatted, err := format.Source(content)
+		if err != nil {
+			return err
+		}
+		files[name] = formatted
+	}
+	if err := safefileWriteFiles(files, 0666); err != nil {
+		return err
+	}
+	return removeStaleShards(c, shards)
 }
 
 // Implement sort.Interface for []os.FileInfo based on Name()
@@ -124,8 +214,11 @@ func (v byName) Less(i, j int) bool { return v[i].Name() < v[j].Name() }
 
 // findFiles recursively finds all the file paths in the given directory tree.
 // They are added to the given map as keys. Values will be safe function names
-// for each file, which will be used when generating the output code.
-func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regexp.Regexp, knownFuncs map[string]int, visitedPaths map[string]bool) error {
+// for each file, which will be used when generating the output code. hash
+// selects whether each asset's content is also hashed for deduplication;
+// callers pass false for debug/dev builds, where writeDebug re-reads every
+// asset from disk at runtime anyway and never consults Hash or DupOf.
+func findFiles(fs SourceFS, dir, root, prefix string, recursive bool, toc *[]Asset, ignore []*regexp.Regexp, globs []globPattern, knownFuncs map[string]int, visitedPaths map[string]bool, hash bool) error {
 	dirpath := dir
 	if len(prefix) > 0 {
 		dirpath, _ = filepath.Abs(dirpath)
@@ -133,7 +226,7 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 		prefix = filepath.ToSlash(prefix)
 	}
 
-	fi, err := os.Stat(dirpath)
+	fi, err := fs.Stat(dirpath)
 	if err != nil {
 		return err
 	}
@@ -145,14 +238,7 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 		list = []os.FileInfo{fi}
 	} else {
 		visitedPaths[dirpath] = true
-		fd, err := os.Open(dirpath)
-		if err != nil {
-			return err
-		}
-
-		defer fd.Close()
-
-		list, err = fd.Readdir(0)
+		list, err = fs.ReadDir(dirpath)
 		if err != nil {
 			return err
 		}
@@ -173,6 +259,18 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 				break
 			}
 		}
+		if !ignoring && len(globs) > 0 {
+			// root is always absolute (Translate resolves it via
+			// filepath.Abs), but asset.Path only is when Prefix is set
+			// (see dirpath above), so resolve it here too -- otherwise
+			// Rel always errs on the absolute/relative mismatch and every
+			// glob silently matches nothing.
+			if absPath, err := filepath.Abs(asset.Path); err == nil {
+				if relPath, err := filepath.Rel(root, absPath); err == nil {
+					ignoring = globIgnored(globs, filepath.ToSlash(relPath), file.IsDir())
+				}
+			}
+		}
 		if ignoring {
 			continue
 		}
@@ -181,12 +279,12 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 			if recursive {
 				recursivePath := filepath.Join(dir, file.Name())
 				visitedPaths[asset.Path] = true
-				findFiles(recursivePath, prefix, recursive, toc, ignore, knownFuncs, visitedPaths)
+				findFiles(fs, recursivePath, root, prefix, recursive, toc, ignore, globs, knownFuncs, visitedPaths, hash)
 			}
 			continue
 		} else if file.Mode()&os.ModeSymlink == os.ModeSymlink {
 			var linkPath string
-			if linkPath, err = os.Readlink(asset.Path); err != nil {
+			if linkPath, err = fs.Readlink(asset.Path); err != nil {
 				return err
 			}
 			if !filepath.IsAbs(linkPath) {
@@ -196,7 +294,7 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 			}
 			if _, ok := visitedPaths[linkPath]; !ok {
 				visitedPaths[linkPath] = true
-				findFiles(asset.Path, prefix, recursive, toc, ignore, knownFuncs, visitedPaths)
+				findFiles(fs, asset.Path, root, prefix, recursive, toc, ignore, globs, knownFuncs, visitedPaths, hash)
 			}
 			continue
 		}
@@ -222,12 +320,34 @@ func findFiles(dir, prefix string, recursive bool, toc *[]Asset, ignore []*regex
 		if err != nil {
 			return err
 		}
+		if hash {
+			if asset.Hash, err = hashFile(fs, asset.Path); err != nil {
+				return err
+			}
+		}
 		*toc = append(*toc, asset)
 	}
 
 	return nil
 }
 
+// hashFile streams path's contents through SHA-256 to key assets with
+// identical content for deduplication, without holding the whole file
+// in memory.
+func hashFile(fs SourceFS, path string) (string, error) {
+	fd, err := fs.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer fd.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, fd); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 var regFuncName = regexp.MustCompile(`[^a-zA-Z0-9_]`)
 
 // safeFunctionName converts the given name into a name
@@ -0,0 +1,237 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, path string) {
+	t.Helper()
+	src, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading generated output: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), path, src, parser.AllErrors); err != nil {
+		t.Fatalf("generated output doesn't parse: %v\n%s", err, src)
+	}
+}
+
+// TestTranslateSourceFS drives Translate entirely off an in-memory
+// SourceFS, confirming Config.FS is actually consulted instead of disk.
+func TestTranslateSourceFS(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/foo.txt", []byte("hello"))
+
+	out := filepath.Join(t.TempDir(), "bindata.go")
+	c := &Config{
+		Package: "bindata",
+		FS:      fs,
+		Input:   []InputConfig{{Path: "/assets"}},
+		Output:  out,
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	src, _ := os.ReadFile(out)
+	if !bytes.Contains(src, []byte(`_bindata["assets/foo.txt"]`)) {
+		t.Errorf("generated output missing TOC entry for foo.txt:\n%s", src)
+	}
+}
+
+// TestTranslateIgnoreGlobs exercises IgnoreGlobs with no Config.Prefix set
+// -- the default, most common case -- to guard against it silently
+// matching nothing.
+func TestTranslateIgnoreGlobs(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/app.js", []byte("app"))
+	fs.addFile("/assets/app.js.map", []byte("map"))
+
+	out := filepath.Join(t.TempDir(), "bindata.go")
+	c := &Config{
+		Package:     "bindata",
+		FS:          fs,
+		Input:       []InputConfig{{Path: "/assets"}},
+		Output:      out,
+		IgnoreGlobs: []string{"**/*.map"},
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	src, _ := os.ReadFile(out)
+	if bytes.Contains(src, []byte(`app.js.map`)) {
+		t.Errorf("IgnoreGlobs should have excluded app.js.map:\n%s", src)
+	}
+	if !bytes.Contains(src, []byte(`_bindata["assets/app.js"]`)) {
+		t.Errorf("generated output missing TOC entry for app.js:\n%s", src)
+	}
+}
+
+// TestTranslateIgnoreGlobsDirDescent checks that a directory-matching
+// IgnoreGlobs pattern prunes the whole subtree instead of merely
+// excluding the directory entry itself.
+func TestTranslateIgnoreGlobsDirDescent(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/node_modules/dep/index.js", []byte("dep"))
+	fs.addFile("/assets/app.js", []byte("app"))
+
+	out := filepath.Join(t.TempDir(), "bindata.go")
+	c := &Config{
+		Package:     "bindata",
+		FS:          fs,
+		Input:       []InputConfig{{Path: "/assets", Recursive: true}},
+		Output:      out,
+		IgnoreGlobs: []string{"node_modules/"},
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	src, _ := os.ReadFile(out)
+	if bytes.Contains(src, []byte(`index.js`)) {
+		t.Errorf("IgnoreGlobs should have pruned node_modules entirely:\n%s", src)
+	}
+	if !bytes.Contains(src, []byte(`_bindata["assets/app.js"]`)) {
+		t.Errorf("generated output missing TOC entry for app.js:\n%s", src)
+	}
+}
+
+// TestTranslateParallelismDeterministic checks that Config.Parallelism
+// only affects how fast Translate runs, never what it produces.
+func TestTranslateParallelismDeterministic(t *testing.T) {
+	fs := newMemFS()
+	for i := 0; i < 8; i++ {
+		fs.addFile(filepath.Join("/assets", "file"+string(rune('a'+i))+".txt"), []byte(strings.Repeat("x", i+1)))
+	}
+
+	render := func(parallelism int) []byte {
+		out := filepath.Join(t.TempDir(), "bindata.go")
+		c := &Config{
+			Package:     "bindata",
+			FS:          fs,
+			Input:       []InputConfig{{Path: "/assets"}},
+			Output:      out,
+			Parallelism: parallelism,
+		}
+		if err := Translate(c); err != nil {
+			t.Fatalf("Translate(parallelism=%d): %v", parallelism, err)
+		}
+		src, _ := os.ReadFile(out)
+		return src
+	}
+
+	sequential := render(1)
+	parallel := render(8)
+	if !bytes.Equal(sequential, parallel) {
+		t.Errorf("Translate output differs between Parallelism=1 and Parallelism=8")
+	}
+}
+
+// TestTranslateSharding checks that a small MaxFileSize splits the release
+// output across shard files, and that a later unsharded run removes them.
+func TestTranslateSharding(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/a.txt", []byte(strings.Repeat("a", 64)))
+	fs.addFile("/assets/b.txt", []byte(strings.Repeat("b", 64)))
+	fs.addFile("/assets/c.txt", []byte(strings.Repeat("c", 64)))
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "bindata.go")
+	c := &Config{
+		Package:     "bindata",
+		FS:          fs,
+		Input:       []InputConfig{{Path: "/assets"}},
+		Output:      out,
+		MaxFileSize: 1,
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	shards, _ := filepath.Glob(filepath.Join(dir, "bindata_*.go"))
+	if len(shards) == 0 {
+		t.Fatalf("expected sharded output, found no bindata_*.go files")
+	}
+	for _, shard := range shards {
+		mustParse(t, shard)
+	}
+
+	// Regenerating with sharding disabled must clean up the old shards.
+	c.MaxFileSize = -1
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate (unsharded): %v", err)
+	}
+	shards, _ = filepath.Glob(filepath.Join(dir, "bindata_*.go"))
+	if len(shards) != 0 {
+		t.Errorf("stale shard files left behind after disabling sharding: %v", shards)
+	}
+}
+
+// TestTranslateIntegrity checks that Config.Integrity registers a
+// Subresource Integrity digest for each asset.
+func TestTranslateIntegrity(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/foo.txt", []byte("hello"))
+
+	out := filepath.Join(t.TempDir(), "bindata.go")
+	c := &Config{
+		Package:   "bindata",
+		FS:        fs,
+		Input:     []InputConfig{{Path: "/assets"}},
+		Output:    out,
+		Integrity: true,
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	src, _ := os.ReadFile(out)
+	if !bytes.Contains(src, []byte(`_bindataIntegrity["assets/foo.txt"] = []string{"sha256-`)) {
+		t.Errorf("generated output missing integrity digest for foo.txt:\n%s", src)
+	}
+}
+
+// TestTranslateDedup checks that assets with identical content share one
+// compiled-in copy, while the TOC still lists every original path.
+func TestTranslateDedup(t *testing.T) {
+	fs := newMemFS()
+	fs.addFile("/assets/theme-a/logo.png", []byte("same-bytes"))
+	fs.addFile("/assets/theme-b/logo.png", []byte("same-bytes"))
+	fs.addFile("/assets/unique.png", []byte("different-bytes"))
+
+	out := filepath.Join(t.TempDir(), "bindata.go")
+	c := &Config{
+		Package:    "bindata",
+		FS:         fs,
+		Input:      []InputConfig{{Path: "/assets", Recursive: true}},
+		Output:     out,
+		NoCompress: true,
+	}
+	if err := Translate(c); err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	mustParse(t, out)
+
+	src, _ := os.ReadFile(out)
+	if !bytes.Contains(src, []byte(`_bindata["assets/theme-a/logo.png"]`)) || !bytes.Contains(src, []byte(`_bindata["assets/theme-b/logo.png"]`)) {
+		t.Errorf("TOC must still list every original path for deduplicated assets:\n%s", src)
+	}
+	if n := bytes.Count(src, []byte(`= []byte("same-bytes"`)); n != 1 {
+		t.Errorf("expected identical content to be compiled in once, found %d copies", n)
+	}
+}
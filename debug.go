@@ -0,0 +1,55 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"fmt"
+	"io"
+)
+
+// writeDebug writes the debug code file, which, rather than compiling
+// assets into the binary, reads them from their original path on disk
+// each time they're requested. This is handy during development, since
+// it avoids a regen/rebuild cycle on every asset edit.
+func writeDebug(w io.Writer, c *Config, toc []Asset) error {
+	for i := range toc {
+		if err := writeDebugAsset(w, c, &toc[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeDebugAsset writes a function that reads a single asset at call
+// time from its absolute path on the local disk, via ioutil.ReadFile and
+// os.Stat. The Config's FS is consulted only during generation; the
+// generated function does not read through it, so an asset found in a
+// virtual source tree must also exist at that path on the local disk
+// when the generated code runs.
+func writeDebugAsset(w io.Writer, c *Config, asset *Asset) error {
+	// Fail generation early if the asset is missing from the FS it was
+	// discovered through.
+	if _, err := c.FS.Stat(asset.Path); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, `func %s() (*asset, error) {
+	path := %q
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asset{bytes: bytes, info: fi}, nil
+}
+
+`, asset.Func, asset.Path)
+	return err
+}
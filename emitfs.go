@@ -0,0 +1,243 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"fmt"
+	"io"
+)
+
+// writeFS writes an io/fs.FS implementation (AssetsFS) built on top of
+// the _bindata table and _bintree already written by writeTOC and
+// writeTOCTree, so the generated package can be handed directly to
+// http.FileServer, template.ParseFS and similar fs.FS-based APIs.
+func writeFS(w io.Writer, c *Config) error {
+	if _, err := fmt.Fprint(w, `type bindataDirInfo struct {
+	name string
+}
+
+func (fi bindataDirInfo) Name() string       { return fi.name }
+func (fi bindataDirInfo) Size() int64        { return 0 }
+func (fi bindataDirInfo) Mode() os.FileMode  { return os.ModeDir | 0555 }
+func (fi bindataDirInfo) ModTime() time.Time { return time.Time{} }
+func (fi bindataDirInfo) IsDir() bool        { return true }
+func (fi bindataDirInfo) Sys() interface{}   { return nil }
+
+// assetFile lazily decompresses its asset's bytes on the first Read or
+// Stat call, so merely Open-ing a file through AssetsFS does no work.
+type assetFile struct {
+	name string
+	get  func() (*asset, error)
+	a    *asset
+	r    *bytes.Reader
+}
+
+func (f *assetFile) load() error {
+	if f.a != nil {
+		return nil
+	}
+	a, err := f.get()
+	if err != nil {
+		return err
+	}
+	f.a = a
+	f.r = bytes.NewReader(a.bytes)
+	return nil
+}
+
+func (f *assetFile) Stat() (fs.FileInfo, error) {
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f.a.info, nil
+}
+
+func (f *assetFile) Read(p []byte) (int, error) {
+	if err := f.load(); err != nil {
+		return 0, err
+	}
+	return f.r.Read(p)
+}
+
+func (f *assetFile) Close() error { return nil }
+
+// assetDirEntry adapts a _bintree node to fs.DirEntry.
+type assetDirEntry struct {
+	name  string
+	node  *bintree
+}
+
+func (e assetDirEntry) Name() string { return e.name }
+func (e assetDirEntry) IsDir() bool  { return e.node.Func == nil }
+
+func (e assetDirEntry) Type() fs.FileMode {
+	if e.IsDir() {
+		return fs.ModeDir
+	}
+	return 0
+}
+
+func (e assetDirEntry) Info() (fs.FileInfo, error) {
+	if e.IsDir() {
+		return bindataDirInfo{e.name}, nil
+	}
+	a, err := e.node.Func()
+	if err != nil {
+		return nil, err
+	}
+	return a.info, nil
+}
+
+// assetDir implements fs.ReadDirFile over a _bintree directory node.
+type assetDir struct {
+	name    string
+	entries []fs.DirEntry
+	pos     int
+}
+
+func (d *assetDir) Stat() (fs.FileInfo, error) { return bindataDirInfo{d.name}, nil }
+
+func (d *assetDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: fmt.Errorf("is a directory")}
+}
+
+func (d *assetDir) Close() error { return nil }
+
+func (d *assetDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := d.entries[d.pos:]
+		d.pos = len(d.entries)
+		return entries, nil
+	}
+	if d.pos >= len(d.entries) {
+		return nil, io.EOF
+	}
+	end := d.pos + n
+	if end > len(d.entries) {
+		end = len(d.entries)
+	}
+	entries := d.entries[d.pos:end]
+	d.pos = end
+	return entries, nil
+}
+
+// assetFS implements fs.FS (plus fs.ReadDirFS, fs.ReadFileFS, fs.StatFS
+// and fs.GlobFS) over the compiled-in assets.
+type assetFS struct{}
+
+func (assetFS) lookup(name string) (*bintree, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	node := _bintree
+	if name != "." {
+		for _, p := range strings.Split(name, "/") {
+			if node.Children == nil {
+				return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+			}
+			node = node.Children[p]
+			if node == nil {
+				return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+			}
+		}
+	}
+	return node, nil
+}
+
+func (fsys assetFS) dirEntries(name string, node *bintree) []fs.DirEntry {
+	entries := make([]fs.DirEntry, 0, len(node.Children))
+	for childName, child := range node.Children {
+		entries = append(entries, assetDirEntry{name: childName, node: child})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries
+}
+
+func (fsys assetFS) Open(name string) (fs.File, error) {
+	node, err := fsys.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if node.Func == nil {
+		return &assetDir{name: name, entries: fsys.dirEntries(name, node)}, nil
+	}
+	return &assetFile{name: name, get: node.Func}, nil
+}
+
+func (fsys assetFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	node, err := fsys.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if node.Func != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fmt.Errorf("not a directory")}
+	}
+	return fsys.dirEntries(name, node), nil
+}
+
+func (fsys assetFS) ReadFile(name string) ([]byte, error) {
+	node, err := fsys.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if node.Func == nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fmt.Errorf("is a directory")}
+	}
+	a, err := node.Func()
+	if err != nil {
+		return nil, err
+	}
+	return a.bytes, nil
+}
+
+func (fsys assetFS) Stat(name string) (fs.FileInfo, error) {
+	node, err := fsys.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if node.Func == nil {
+		return bindataDirInfo{name}, nil
+	}
+	a, err := node.Func()
+	if err != nil {
+		return nil, err
+	}
+	return a.info, nil
+}
+
+func (fsys assetFS) Glob(pattern string) ([]string, error) {
+	var matches []string
+	for _, name := range AssetNames() {
+		ok, err := path.Match(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matches = append(matches, name)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// AssetsFS exposes the compiled-in assets as an io/fs.FS, suitable for
+// use with http.FileServer, template.ParseFS and similar APIs.
+var AssetsFS fs.FS = assetFS{}
+
+`); err != nil {
+		return err
+	}
+
+	if c.EmitHTTPFileSystem {
+		_, err := fmt.Fprint(w, `// AssetsHTTPFS wraps AssetsFS for net/http call sites that still
+// expect an http.FileSystem rather than an io/fs.FS.
+var AssetsHTTPFS = http.FS(AssetsFS)
+
+`)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"io"
+	"os"
+)
+
+// File is the subset of *os.File that go-bindata needs in order to read
+// an asset's contents.
+type File interface {
+	io.Reader
+	io.Closer
+}
+
+// SourceFS abstracts the filesystem calls go-bindata needs in order to
+// discover and read the assets it packages. Config defaults to osFS,
+// which talks directly to the local disk, but callers can supply their
+// own implementation -- an in-memory tree (handy for tests and other
+// code generators), a zip or tarball, or an afero-backed overlay such as
+// used by spf13/afero -- to drive Translate without touching disk.
+type SourceFS interface {
+	// Stat returns file info describing path.
+	Stat(path string) (os.FileInfo, error)
+
+	// Open opens path for reading.
+	Open(path string) (File, error)
+
+	// ReadDir returns the (unsorted) directory entries of path.
+	ReadDir(path string) ([]os.FileInfo, error)
+
+	// Readlink returns the destination of the named symbolic link.
+	Readlink(path string) (string, error)
+}
+
+// osFS implements SourceFS on top of the local disk. It is the default
+// used by Config when FS is left nil.
+type osFS struct{}
+
+func (osFS) Stat(path string) (os.FileInfo, error) { return os.Stat(path) }
+
+func (osFS) Open(path string) (File, error) { return os.Open(path) }
+
+func (osFS) ReadDir(path string) ([]os.FileInfo, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return fd.Readdir(0)
+}
+
+func (osFS) Readlink(path string) (string, error) { return os.Readlink(path) }
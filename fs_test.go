@@ -0,0 +1,108 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// memFS is a minimal in-memory SourceFS double, so tests can drive
+// Translate end-to-end without touching disk.
+type memFS struct {
+	infos map[string]*memFileInfo
+	data  map[string][]byte
+	links map[string]string
+}
+
+func newMemFS() *memFS {
+	return &memFS{
+		infos: map[string]*memFileInfo{},
+		data:  map[string][]byte{},
+		links: map[string]string{},
+	}
+}
+
+func (m *memFS) addDir(path string) {
+	path = filepath.Clean(path)
+	if _, ok := m.infos[path]; ok {
+		return
+	}
+	m.infos[path] = &memFileInfo{name: filepath.Base(path), dir: true, mode: os.ModeDir | 0755}
+	if parent := filepath.Dir(path); parent != path {
+		m.addDir(parent)
+	}
+}
+
+func (m *memFS) addFile(path string, data []byte) {
+	path = filepath.Clean(path)
+	m.addDir(filepath.Dir(path))
+	m.infos[path] = &memFileInfo{name: filepath.Base(path), size: int64(len(data)), mode: 0644, modTime: time.Unix(1000, 0)}
+	m.data[path] = data
+}
+
+func (m *memFS) addSymlink(path, target string) {
+	path = filepath.Clean(path)
+	m.addDir(filepath.Dir(path))
+	m.links[path] = target
+	m.infos[path] = &memFileInfo{name: filepath.Base(path), mode: os.ModeSymlink}
+}
+
+func (m *memFS) Stat(path string) (os.FileInfo, error) {
+	if info, ok := m.infos[filepath.Clean(path)]; ok {
+		return info, nil
+	}
+	return nil, &os.PathError{Op: "stat", Path: path, Err: os.ErrNotExist}
+}
+
+func (m *memFS) Open(path string) (File, error) {
+	if data, ok := m.data[filepath.Clean(path)]; ok {
+		return &memFile{Reader: bytes.NewReader(data)}, nil
+	}
+	return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+}
+
+func (m *memFS) ReadDir(path string) ([]os.FileInfo, error) {
+	path = filepath.Clean(path)
+	var list []os.FileInfo
+	for p, info := range m.infos {
+		if p != path && filepath.Dir(p) == path {
+			list = append(list, info)
+		}
+	}
+	return list, nil
+}
+
+func (m *memFS) Readlink(path string) (string, error) {
+	if target, ok := m.links[filepath.Clean(path)]; ok {
+		return target, nil
+	}
+	return "", &os.PathError{Op: "readlink", Path: path, Err: os.ErrNotExist}
+}
+
+// memFile implements File (io.Reader + io.Closer) over an in-memory buffer.
+type memFile struct {
+	*bytes.Reader
+}
+
+func (memFile) Close() error { return nil }
+
+// memFileInfo implements os.FileInfo for entries served by memFS.
+type memFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+	dir     bool
+}
+
+func (fi *memFileInfo) Name() string       { return fi.name }
+func (fi *memFileInfo) Size() int64        { return fi.size }
+func (fi *memFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi *memFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *memFileInfo) IsDir() bool        { return fi.dir }
+func (fi *memFileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,104 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// globPattern is a single compiled entry from Config.IgnoreGlobs.
+type globPattern struct {
+	pattern  string
+	negate   bool // leading "!": un-ignores a path matched by an earlier pattern.
+	anchored bool // a "/" anywhere but trailing, unless it begins with "**/": only matches relative to the input root.
+	dirOnly  bool // trailing "/": only matches directories.
+}
+
+// compileIgnoreGlobs parses Config.IgnoreGlobs into globPattern values,
+// preserving order -- later patterns must override earlier ones during
+// matching, exactly as git itself evaluates a .gitignore file.
+func compileIgnoreGlobs(globs []string) []globPattern {
+	patterns := make([]globPattern, 0, len(globs))
+	for _, raw := range globs {
+		if raw == "" {
+			continue
+		}
+		p := globPattern{pattern: raw}
+		if strings.HasPrefix(p.pattern, "!") {
+			p.negate = true
+			p.pattern = p.pattern[1:]
+		}
+		if strings.HasPrefix(p.pattern, "/") {
+			p.anchored = true
+			p.pattern = p.pattern[1:]
+		}
+		// Per gitignore(5): a slash anywhere but the last character
+		// anchors the pattern to the root, even without a leading
+		// slash -- only a slash-free pattern (or one whose only slash
+		// is a trailing dir marker) floats at any depth. A leading
+		// "**/" is the documented exception: it explicitly means
+		// "match in all directories", so it doesn't anchor either.
+		body := strings.TrimSuffix(p.pattern, "/")
+		if strings.Contains(body, "/") && !strings.HasPrefix(body, "**/") {
+			p.anchored = true
+		}
+		if strings.HasSuffix(p.pattern, "/") {
+			p.dirOnly = true
+			p.pattern = strings.TrimSuffix(p.pattern, "/")
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
+// globIgnored reports whether relPath (slash-separated, relative to the
+// input root currently being scanned) is ignored by patterns. Patterns
+// are evaluated in order, each match overriding the previous verdict, so
+// a later "!pattern" can un-ignore a path an earlier pattern excluded.
+func globIgnored(patterns []globPattern, relPath string, isDir bool) bool {
+	ignored := false
+	for _, p := range patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if globPatternMatches(p, relPath) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
+
+// globPatternMatches reports whether relPath matches a single pattern,
+// following gitignore's matching rules: an anchored pattern matches only
+// against the full relative path; an unanchored pattern with a leading
+// "**/" matches against the relative path with any number of leading
+// directories stripped off the front; a pattern without a slash matches
+// against the base name at any depth.
+func globPatternMatches(p globPattern, relPath string) bool {
+	pattern := p.pattern
+
+	if !p.anchored && !strings.Contains(pattern, "/") {
+		base := relPath
+		if idx := strings.LastIndex(relPath, "/"); idx >= 0 {
+			base = relPath[idx+1:]
+		}
+		ok, _ := filepath.Match(pattern, base)
+		return ok
+	}
+
+	pattern = strings.TrimPrefix(pattern, "**/")
+	segs := strings.Split(relPath, "/")
+	for i := range segs {
+		sub := strings.Join(segs[i:], "/")
+		if ok, _ := filepath.Match(pattern, sub); ok {
+			return true
+		}
+		if p.anchored {
+			break
+		}
+	}
+	return false
+}
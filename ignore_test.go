@@ -0,0 +1,61 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import "testing"
+
+// TestGlobIgnoredAnchoring checks that a pattern containing a slash
+// anywhere but a trailing position is anchored to the root, matching
+// git check-ignore: "src/assets/foo.map" must ignore that exact path but
+// not "other/src/assets/foo.map".
+func TestGlobIgnoredAnchoring(t *testing.T) {
+	globs := compileIgnoreGlobs([]string{"src/assets/foo.map"})
+
+	if !globIgnored(globs, "src/assets/foo.map", false) {
+		t.Errorf("expected src/assets/foo.map to be ignored")
+	}
+	if globIgnored(globs, "other/src/assets/foo.map", false) {
+		t.Errorf("anchored pattern must not match at a deeper nesting level")
+	}
+}
+
+// TestGlobIgnoredDoubleStarPrefix checks that a "**/" prefix is the
+// documented exception to slash-anchoring: it floats at any depth.
+func TestGlobIgnoredDoubleStarPrefix(t *testing.T) {
+	globs := compileIgnoreGlobs([]string{"**/foo.map"})
+
+	if !globIgnored(globs, "foo.map", false) {
+		t.Errorf("expected top-level foo.map to be ignored")
+	}
+	if !globIgnored(globs, "other/src/assets/foo.map", false) {
+		t.Errorf("expected nested foo.map to be ignored via \"**/\" prefix")
+	}
+}
+
+// TestGlobIgnoredNegation checks that a later "!pattern" un-ignores a
+// path an earlier pattern excluded.
+func TestGlobIgnoredNegation(t *testing.T) {
+	globs := compileIgnoreGlobs([]string{"*.log", "!important.log"})
+
+	if !globIgnored(globs, "debug.log", false) {
+		t.Errorf("expected debug.log to be ignored")
+	}
+	if globIgnored(globs, "important.log", false) {
+		t.Errorf("expected important.log to be un-ignored by the negated pattern")
+	}
+}
+
+// TestGlobIgnoredDirOnly checks that a trailing-slash pattern only
+// matches directories, never a same-named file.
+func TestGlobIgnoredDirOnly(t *testing.T) {
+	globs := compileIgnoreGlobs([]string{"build/"})
+
+	if !globIgnored(globs, "build", true) {
+		t.Errorf("expected directory build to be ignored")
+	}
+	if globIgnored(globs, "build", false) {
+		t.Errorf("dir-only pattern must not match a file named build")
+	}
+}
@@ -0,0 +1,74 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// writeImports writes the import block for the generated file. Only the
+// packages the enabled features actually reference are listed, so e.g. a
+// package built with CompressionZstd never pulls in brotli.
+func writeImports(w io.Writer, c *Config) error {
+	set := map[string]bool{
+		"fmt":           true,
+		"os":            true,
+		"strings":       true,
+		"path/filepath": true,
+		"io/ioutil":     true,
+	}
+
+	if !(c.Debug || c.Dev) {
+		set["time"] = true
+		if c.Compression != CompressionNone {
+			// Every codec's bindataRead uses bytes and io.
+			set["bytes"] = true
+			set["io"] = true
+		}
+		switch c.Compression {
+		case CompressionBrotli:
+			set["github.com/andybalholm/brotli"] = true
+		case CompressionZstd:
+			set["github.com/klauspost/compress/zstd"] = true
+		case CompressionNone:
+			// No codec package is needed: with the package-wide codec
+			// already None, no asset ever falls back further.
+		default:
+			set["compress/gzip"] = true
+		}
+	}
+
+	if c.EmitFS {
+		set["io/fs"] = true
+		set["sort"] = true
+		set["bytes"] = true
+		set["io"] = true
+		set["time"] = true
+		set["path"] = true
+	}
+
+	if c.EmitHTTPFileSystem {
+		set["net/http"] = true
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if _, err := fmt.Fprint(w, "import (\n"); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "\t%q\n", name); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, ")\n\n")
+	return err
+}
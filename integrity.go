@@ -0,0 +1,155 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+// IntegrityAlgorithm selects a hash algorithm used to compute
+// Subresource-Integrity-style digests for each asset.
+type IntegrityAlgorithm int
+
+const (
+	// IntegritySHA256 is the default, and the only algorithm widely
+	// supported by <script integrity=...> / <link integrity=...>.
+	IntegritySHA256 IntegrityAlgorithm = iota
+	IntegritySHA384
+	IntegritySHA512
+)
+
+func (a IntegrityAlgorithm) sriName() string {
+	switch a {
+	case IntegritySHA384:
+		return "sha384"
+	case IntegritySHA512:
+		return "sha512"
+	default:
+		return "sha256"
+	}
+}
+
+func (a IntegrityAlgorithm) newHash() hash.Hash {
+	switch a {
+	case IntegritySHA384:
+		return sha512.New384()
+	case IntegritySHA512:
+		return sha512.New()
+	default:
+		return sha256.New()
+	}
+}
+
+// computeIntegrity returns an SRI-formatted digest ("sha256-<base64>")
+// for raw under each of algos, computed from the asset's uncompressed
+// bytes so it matches what a browser sees over the wire, regardless of
+// Config.Compression.
+func computeIntegrity(algos []IntegrityAlgorithm, raw []byte) []string {
+	sris := make([]string, len(algos))
+	for i, algo := range algos {
+		h := algo.newHash()
+		h.Write(raw)
+		sris[i] = algo.sriName() + "-" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+	}
+	return sris
+}
+
+// writeIntegrityHeader writes the Subresource Integrity API: accessors
+// plus the (initially empty) table they read from, populated by
+// writeIntegrityRegister the same way writeTOC's _bindata table is.
+func writeIntegrityHeader(w io.Writer) error {
+	_, err := io.WriteString(w, `// AssetIntegrity returns a Subresource Integrity digest (e.g.
+// "sha256-...") for the named asset, suitable for a <script
+// integrity="..."> or <link integrity="..."> attribute.
+func AssetIntegrity(name string) (string, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	sris, ok := _bindataIntegrity[cannonicalName]
+	if !ok || len(sris) == 0 {
+		return "", fmt.Errorf("AssetIntegrity %s not found", name)
+	}
+	return sris[0], nil
+}
+
+// AssetsIntegrity returns every asset's primary Subresource Integrity
+// digest, keyed by name, handy for generating a manifest.
+func AssetsIntegrity() map[string]string {
+	m := make(map[string]string, len(_bindataIntegrity))
+	for name, sris := range _bindataIntegrity {
+		if len(sris) > 0 {
+			m[name] = sris[0]
+		}
+	}
+	return m
+}
+
+// _bindataIntegrity holds each asset's Subresource Integrity digests,
+// keyed by name, one per configured IntegrityAlgorithm.
+var _bindataIntegrity = map[string][]string{}
+
+`)
+	return err
+}
+
+// buildIntegrityDigests maps each asset's Name to its Subresource
+// Integrity digests. A duplicate asset (DupOf set) has no digest of its
+// own -- encodeReleaseAssetDup never computes one -- so its entry is
+// filled in from the asset it duplicates, since identical content
+// hashes identically.
+func buildIntegrityDigests(toc []Asset, chunks []releaseAsset) map[string][]string {
+	byFunc := make(map[string][]string, len(toc))
+	for i := range toc {
+		if len(chunks[i].integrity) > 0 {
+			byFunc[toc[i].Func] = chunks[i].integrity
+		}
+	}
+
+	digests := make(map[string][]string, len(toc))
+	for i := range toc {
+		if len(chunks[i].integrity) > 0 {
+			digests[toc[i].Name] = chunks[i].integrity
+		} else if sris, ok := byFunc[toc[i].DupOf]; ok {
+			digests[toc[i].Name] = sris
+		}
+	}
+	return digests
+}
+
+// writeIntegrityRegister writes an init() that registers a slice of
+// assets' digests into _bindataIntegrity. Assets with no computed digest
+// (Config.Integrity was disabled) are skipped.
+func writeIntegrityRegister(w io.Writer, assets []Asset, digests map[string][]string) error {
+	var lines []string
+	for i := range assets {
+		sris := digests[assets[i].Name]
+		if len(sris) == 0 {
+			continue
+		}
+		quoted := make([]string, len(sris))
+		for j, s := range sris {
+			quoted[j] = fmt.Sprintf("%q", s)
+		}
+		lines = append(lines, fmt.Sprintf("\t_bindataIntegrity[%q] = []string{%s}\n", assets[i].Name, strings.Join(quoted, ", ")))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprint(w, "func init() {\n"); err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprint(w, line); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, "}\n\n")
+	return err
+}
@@ -0,0 +1,268 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// releaseAsset is the result of encoding a single asset: its generated
+// Go source, plus the Subresource Integrity digests computed from its
+// uncompressed bytes (nil unless Config.Integrity is set).
+type releaseAsset struct {
+	src       []byte
+	integrity []string
+}
+
+// encodeReleaseAssets renders every asset's compiled-in Go source
+// independently -- read, compress, format -- fanning the work out across
+// a worker pool sized by Config.Parallelism (GOMAXPROCS by default), and
+// returns the results in TOC order. Byte-for-byte output never depends
+// on how many workers produced it, only on toc and c, so callers can
+// freely split the returned chunks across shard files.
+func encodeReleaseAssets(c *Config, toc []Asset) ([]releaseAsset, error) {
+	parallelism := c.Parallelism
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	if parallelism > len(toc) {
+		parallelism = len(toc)
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	type result struct {
+		asset releaseAsset
+		err   error
+	}
+	results := make([]result, len(toc))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for n := 0; n < parallelism; n++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				asset, err := encodeReleaseAsset(c, &toc[i])
+				results[i] = result{asset: asset, err: err}
+			}
+		}()
+	}
+	for i := range toc {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	assets := make([]releaseAsset, len(toc))
+	for i := range results {
+		if results[i].err != nil {
+			return nil, results[i].err
+		}
+		assets[i] = results[i].asset
+	}
+	return assets, nil
+}
+
+// writeReleaseHeader writes the shared helpers every compiled-in asset
+// relies on: a decompressing reader for each codec an asset might be
+// tagged with, and the asset/FileInfo wrappers returned by each asset's
+// getter. Only the reader for c.Compression is emitted -- an individual
+// asset may still fall back to storing its bytes uncompressed (see
+// compress), which needs no reader at all.
+func writeReleaseHeader(w io.Writer, c *Config) error {
+	switch c.Compression {
+	case CompressionBrotli:
+		if _, err := io.WriteString(w, `func bindataRead(data []byte, name string) ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, brotli.NewReader(bytes.NewBuffer(data)))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+`); err != nil {
+			return err
+		}
+	case CompressionZstd:
+		if _, err := io.WriteString(w, `func bindataRead(data []byte, name string) ([]byte, error) {
+	zr, err := zstd.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", name, err)
+	}
+	defer zr.Close()
+
+	buf, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", name, err)
+	}
+	return buf, nil
+}
+
+`); err != nil {
+			return err
+		}
+	case CompressionNone:
+		// compress() never falls back to a codec when the requested one
+		// is already CompressionNone, so no asset will call bindataRead;
+		// unlike the Gzip/Brotli/Zstd cases (where an individual asset
+		// may still end up stored uncompressed via compress's
+		// isPrecompressed check), there's nothing to skip decompressing
+		// and importing "compress/gzip" just for this would be unused.
+	default:
+		if _, err := io.WriteString(w, `func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+`); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprint(w, `type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string       { return fi.name }
+func (fi bindataFileInfo) Size() int64        { return fi.size }
+func (fi bindataFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi bindataFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi bindataFileInfo) IsDir() bool        { return false }
+func (fi bindataFileInfo) Sys() interface{}   { return nil }
+
+`)
+	return err
+}
+
+// encodeReleaseAsset renders a single compiled-in asset -- its
+// compressed bytes, a getter that decompresses them, and a getter that
+// wraps the result (plus file metadata) in an *asset -- into its own
+// buffer. It touches no shared state besides c.FS, so it's safe to call
+// concurrently for distinct assets.
+//
+// If asset.DupOf is set, some earlier asset has identical content: its
+// bytes are already compiled in under that asset's Func, so this asset
+// only needs a thin wrapper that borrows them, saving the encode and
+// the read.
+func encodeReleaseAsset(c *Config, asset *Asset) (releaseAsset, error) {
+	if asset.DupOf != "" {
+		return encodeReleaseAssetDup(c, asset)
+	}
+
+	fd, err := c.FS.Open(asset.Path)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+	defer fd.Close()
+
+	raw, err := io.ReadAll(fd)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+
+	var integrity []string
+	if c.Integrity {
+		integrity = computeIntegrity(c.IntegrityAlgorithms, raw)
+	}
+
+	codec, encoded, err := compress(c.Compression, raw)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+
+	fi, err := c.FS.Stat(asset.Path)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "var _%s = []byte(%q)\n\n", asset.Func, string(encoded))
+
+	if codec == CompressionNone {
+		fmt.Fprintf(&out, `func %sBytes() ([]byte, error) {
+	return _%s, nil
+}
+
+`, asset.Func, asset.Func)
+	} else {
+		fmt.Fprintf(&out, `func %sBytes() ([]byte, error) {
+	return bindataRead(
+		_%s,
+		%q,
+	)
+}
+
+`, asset.Func, asset.Func, asset.Name)
+	}
+
+	fmt.Fprintf(&out, `func %s() (*asset, error) {
+	bytes, err := %sBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: %q, size: %d, mode: os.FileMode(%d), modTime: time.Unix(%d, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+`, asset.Func, asset.Func, asset.Name, fi.Size(), uint32(fi.Mode()), fi.ModTime().Unix())
+
+	return releaseAsset{src: out.Bytes(), integrity: integrity}, nil
+}
+
+// encodeReleaseAssetDup renders the thin wrapper used for an asset whose
+// content duplicates an earlier one: it calls through to the earlier
+// asset's Bytes getter instead of compiling in its own copy, and carries
+// no integrity digest of its own -- buildIntegrityDigests fills that in
+// from the asset it duplicates.
+func encodeReleaseAssetDup(c *Config, asset *Asset) (releaseAsset, error) {
+	fi, err := c.FS.Stat(asset.Path)
+	if err != nil {
+		return releaseAsset{}, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, `func %s() (*asset, error) {
+	bytes, err := %sBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: %q, size: %d, mode: os.FileMode(%d), modTime: time.Unix(%d, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+`, asset.Func, asset.DupOf, asset.Name, fi.Size(), uint32(fi.Mode()), fi.ModTime().Unix())
+
+	return releaseAsset{src: out.Bytes()}, nil
+}
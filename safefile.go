@@ -0,0 +1,54 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// safefileWriteFile writes data to a temporary file in the same
+// directory as path, then renames it into place. This avoids leaving a
+// truncated or partially-written file at path if the process is
+// interrupted mid-write, or if another reader opens path while we write.
+func safefileWriteFile(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = os.Chmod(tmpPath, perm); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// safefileWriteFiles writes several files, each via safefileWriteFile.
+// Used when Translate shards its output across a main file and one or
+// more "<base>_N.go" shards.
+func safefileWriteFiles(files map[string][]byte, perm os.FileMode) error {
+	for path, data := range files {
+		if err := safefileWriteFile(path, data, perm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
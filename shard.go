@@ -0,0 +1,149 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// shardReleaseAssets splits the release asset chunks produced by
+// encodeReleaseAssets across "<base>_0.go", "<base>_1.go", ... files
+// once their combined size would push the main output past
+// Config.MaxFileSize, returning nil when no sharding is needed. Each
+// shard is a self-contained, unformatted Go source file: header comment,
+// build tags, package decl, the imports its own chunks need, the chunks
+// themselves, and an init() registering its subset of assets into the
+// shared _bindata table declared in the main output file.
+func shardReleaseAssets(c *Config, toc []Asset, chunks []releaseAsset, digests map[string][]string) (map[string][]byte, error) {
+	if c.MaxFileSize < 0 {
+		return nil, nil
+	}
+
+	var total int64
+	for _, chunk := range chunks {
+		total += int64(len(chunk.src))
+	}
+	if total <= c.MaxFileSize {
+		return nil, nil
+	}
+
+	dir := filepath.Dir(c.Output)
+	base := strings.TrimSuffix(filepath.Base(c.Output), filepath.Ext(c.Output))
+
+	shards := map[string][]byte{}
+	var (
+		shardAssets []Asset
+		shardChunks []releaseAsset
+		shardSize   int64
+		shardIndex  int
+	)
+
+	flush := func() error {
+		if len(shardAssets) == 0 {
+			return nil
+		}
+		content, err := writeShard(c, shardAssets, shardChunks, digests)
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(dir, base+"_"+strconv.Itoa(shardIndex)+".go")
+		shards[name] = content
+		shardIndex++
+		shardAssets = nil
+		shardChunks = nil
+		shardSize = 0
+		return nil
+	}
+
+	for i, chunk := range chunks {
+		if shardSize > 0 && shardSize+int64(len(chunk.src)) > c.MaxFileSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		shardAssets = append(shardAssets, toc[i])
+		shardChunks = append(shardChunks, chunk)
+		shardSize += int64(len(chunk.src))
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return shards, nil
+}
+
+// removeStaleShards deletes any "<base>_N.go" file left over in c.Output's
+// directory from a previous run that isn't among kept -- e.g. a prior run
+// sharded into more files than this one, or sharding was disabled outright.
+// Without this, a stale shard keeps redeclaring symbols the new output
+// already declares, and the generated package fails to compile.
+func removeStaleShards(c *Config, kept map[string][]byte) error {
+	dir := filepath.Dir(c.Output)
+	base := strings.TrimSuffix(filepath.Base(c.Output), filepath.Ext(c.Output))
+
+	matches, err := filepath.Glob(filepath.Join(dir, base+"_*.go"))
+	if err != nil {
+		return err
+	}
+	for _, name := range matches {
+		if _, ok := kept[name]; ok {
+			continue
+		}
+		// The glob also matches e.g. "<base>_test.go"; only remove
+		// files whose suffix is a shard number.
+		n := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), base+"_"), ".go")
+		if _, err := strconv.Atoi(n); err != nil {
+			continue
+		}
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeShard renders one shard file's full source: everything a
+// compiled Go file needs on its own, since format.Source (and the Go
+// compiler after it) operates per file. Its assets' Subresource
+// Integrity digests, if any, register into the _bindataIntegrity table
+// declared in the main output file, same as _bindata.
+func writeShard(c *Config, assets []Asset, chunks []releaseAsset, digests map[string][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, err := fmt.Fprint(&buf, "// Code generated by go-bindata. DO NOT EDIT.\n"); err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(&buf, "// A shard of the compiled-in assets; see %s.\n\n", filepath.Base(c.Output)); err != nil {
+		return nil, err
+	}
+	if len(c.Tags) > 0 {
+		if _, err := fmt.Fprintf(&buf, "// +build %s\n\n", c.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := fmt.Fprintf(&buf, "package %s\n\nimport (\n\t\"os\"\n\t\"time\"\n)\n\n", c.Package); err != nil {
+		return nil, err
+	}
+
+	for _, chunk := range chunks {
+		if _, err := buf.Write(chunk.src); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeTOCRegister(&buf, assets); err != nil {
+		return nil, err
+	}
+	if err := writeIntegrityRegister(&buf, assets, digests); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,201 @@
+// This work is subject to the CC0 1.0 Universal (CC0 1.0) Public Domain Dedication
+// license. Its contents can be found at:
+// http://creativecommons.org/publicdomain/zero/1.0/
+
+package bindata
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// writeTOC writes the table of contents file: the Asset/AssetInfo/
+// AssetNames API and the (initially empty) _bindata table they read
+// from. Entries are registered into _bindata separately, via
+// writeTOCRegister, so that a sharded build can populate the shared
+// table from each shard's own init() instead of one giant map literal.
+func writeTOC(w io.Writer) error {
+	_, err := fmt.Fprintf(w, `// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %%s can't read by error: %%v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %%s not found", name)
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %%s can't read by error: %%v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %%s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+// It's populated by the init() written by writeTOCRegister, in this file
+// or, for a sharded build, in each "<base>_N.go" shard.
+var _bindata = map[string]func() (*asset, error){}
+
+`)
+	return err
+}
+
+// writeTOCRegister writes an init() that registers a slice of assets
+// into _bindata. It's called once with the full TOC for an unsharded
+// build, or once per shard with that shard's subset for a sharded one.
+func writeTOCRegister(w io.Writer, assets []Asset) error {
+	if len(assets) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprint(w, "func init() {\n"); err != nil {
+		return err
+	}
+	for i := range assets {
+		if _, err := fmt.Fprintf(w, "\t_bindata[%q] = %s\n", assets[i].Name, assets[i].Func); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, "}\n\n")
+	return err
+}
+
+// tocTreeNode is a node in the hierarchical directory structure of
+// assets, built from the flat TOC so the generated AssetDir can answer
+// "what lives under this directory" without a linear scan. It mirrors
+// the `bintree` type written into the generated output below, except
+// Func here is the asset function's name rather than the function
+// itself, since this tree only ever gets rendered as source text.
+type tocTreeNode struct {
+	Func     string // Name of the asset function; empty for directory nodes.
+	Children map[string]*tocTreeNode
+}
+
+// writeTOCTree writes the hierarchical tree of assets.
+func writeTOCTree(w io.Writer, toc []Asset) error {
+	tree := &tocTreeNode{Children: map[string]*tocTreeNode{}}
+	for i := range toc {
+		addToBinTree(tree, strings.Split(toc[i].Name, "/"), toc[i].Func)
+	}
+
+	if _, err := io.WriteString(w, `// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = `); err != nil {
+		return err
+	}
+	if err := writeBinTree(w, tree); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, "\n")
+	return err
+}
+
+func addToBinTree(tree *tocTreeNode, path []string, assetFunc string) {
+	name := path[0]
+	if len(path) == 1 {
+		tree.Children[name] = &tocTreeNode{Func: assetFunc, Children: map[string]*tocTreeNode{}}
+		return
+	}
+
+	child, ok := tree.Children[name]
+	if !ok {
+		child = &tocTreeNode{Children: map[string]*tocTreeNode{}}
+		tree.Children[name] = child
+	}
+	addToBinTree(child, path[1:], assetFunc)
+}
+
+// writeBinTree renders a tocTreeNode as a Go literal for the generated
+// output's _bintree. Directory nodes print their Func field as the
+// literal `nil` (a *asset-returning function pointer in the generated
+// output); leaf nodes print the asset function's name so the generated
+// type checks against `func() (*asset, error)`.
+func writeBinTree(w io.Writer, tree *tocTreeNode) error {
+	funcField := "nil"
+	if tree.Func != "" {
+		funcField = tree.Func
+	}
+	if _, err := fmt.Fprintf(w, "&bintree{%s, map[string]*bintree{\n", funcField); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(tree.Children))
+	for name := range tree.Children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "\t%q: ", name); err != nil {
+			return err
+		}
+		if err := writeBinTree(w, tree.Children[name]); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(w, ",\n"); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, "}}")
+	return err
+}